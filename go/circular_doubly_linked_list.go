@@ -0,0 +1,273 @@
+package collections
+
+// CircularDoublyLinkedList is a doubly linked list over an arbitrary
+// element type T whose Tail.Next points back to Head and Head.Prev
+// points back to Tail, forming a ring. Delete and Search rely on the
+// equals func supplied at construction time to compare elements.
+type CircularDoublyLinkedList[T any] struct {
+	Head   *DoublyNode[T]
+	Tail   *DoublyNode[T]
+	size   int
+	equals func(a, b T) bool
+}
+
+// NewCircularDoublyLinkedList creates an empty
+// CircularDoublyLinkedList for a comparable element type, using the
+// built-in == operator for Delete and Search.
+func NewCircularDoublyLinkedList[T comparable]() *CircularDoublyLinkedList[T] {
+	return NewCircularDoublyLinkedListWithEqual(func(a, b T) bool { return a == b })
+}
+
+// NewCircularDoublyLinkedListWithEqual creates an empty
+// CircularDoublyLinkedList for an arbitrary element type T, using
+// equals to compare elements in Delete and Search.
+func NewCircularDoublyLinkedListWithEqual[T any](equals func(a, b T) bool) *CircularDoublyLinkedList[T] {
+	return &CircularDoublyLinkedList[T]{
+		equals: equals,
+	}
+}
+
+func (cdll *CircularDoublyLinkedList[T]) Append(data T) {
+	node := &DoublyNode[T]{Data: data}
+
+	if cdll.Head == nil {
+		node.Next = node
+		node.Prev = node
+		cdll.Head = node
+		cdll.Tail = node
+	} else {
+		node.Prev = cdll.Tail
+		node.Next = cdll.Head
+		cdll.Tail.Next = node
+		cdll.Head.Prev = node
+		cdll.Tail = node
+	}
+
+	cdll.size++
+}
+
+func (cdll *CircularDoublyLinkedList[T]) Prepend(data T) {
+	node := &DoublyNode[T]{Data: data}
+
+	if cdll.Head == nil {
+		node.Next = node
+		node.Prev = node
+		cdll.Head = node
+		cdll.Tail = node
+	} else {
+		node.Next = cdll.Head
+		node.Prev = cdll.Tail
+		cdll.Head.Prev = node
+		cdll.Tail.Next = node
+		cdll.Head = node
+	}
+
+	cdll.size++
+}
+
+func (cdll *CircularDoublyLinkedList[T]) Delete(data T) {
+	current := cdll.Head
+	for i := 0; i < cdll.size; i++ {
+		if cdll.equals(current.Data, data) {
+			cdll.unlink(current)
+			return
+		}
+		current = current.Next
+	}
+}
+
+func (cdll *CircularDoublyLinkedList[T]) unlink(node *DoublyNode[T]) {
+	if cdll.Head == cdll.Tail {
+		cdll.Head = nil
+		cdll.Tail = nil
+	} else {
+		node.Prev.Next = node.Next
+		node.Next.Prev = node.Prev
+		if node == cdll.Head {
+			cdll.Head = node.Next
+		}
+		if node == cdll.Tail {
+			cdll.Tail = node.Prev
+		}
+	}
+
+	cdll.size--
+}
+
+func (cdll *CircularDoublyLinkedList[T]) Search(data T) bool {
+	current := cdll.Head
+	for i := 0; i < cdll.size; i++ {
+		if cdll.equals(current.Data, data) {
+			return true
+		}
+		current = current.Next
+	}
+	return false
+}
+
+// Get returns the element at index, counting forward from Head.
+func (cdll *CircularDoublyLinkedList[T]) Get(index int) (T, bool) {
+	node := cdll.nodeAt(index)
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.Data, true
+}
+
+// InsertAt inserts data at index, shifting the element currently at
+// index (and all that follow) one position towards Tail. Inserting at
+// Size appends to the end of the list.
+func (cdll *CircularDoublyLinkedList[T]) InsertAt(index int, data T) {
+	if index <= 0 {
+		cdll.Prepend(data)
+		return
+	}
+	if index >= cdll.size {
+		cdll.Append(data)
+		return
+	}
+
+	next := cdll.nodeAt(index)
+	node := &DoublyNode[T]{Data: data, Prev: next.Prev, Next: next}
+	next.Prev.Next = node
+	next.Prev = node
+	cdll.size++
+}
+
+// RemoveAt removes the element at index, if any.
+func (cdll *CircularDoublyLinkedList[T]) RemoveAt(index int) {
+	node := cdll.nodeAt(index)
+	if node == nil {
+		return
+	}
+	cdll.unlink(node)
+}
+
+func (cdll *CircularDoublyLinkedList[T]) nodeAt(index int) *DoublyNode[T] {
+	if index < 0 || index >= cdll.size {
+		return nil
+	}
+
+	current := cdll.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// Rotate moves Head n steps forward (n > 0) or backward (n < 0) around
+// the ring in O(n) time, wrapping as many times as needed.
+func (cdll *CircularDoublyLinkedList[T]) Rotate(n int) {
+	if cdll.size == 0 {
+		return
+	}
+
+	steps := n % cdll.size
+	if steps > 0 {
+		for i := 0; i < steps; i++ {
+			cdll.Head = cdll.Head.Next
+			cdll.Tail = cdll.Tail.Next
+		}
+	} else {
+		for i := 0; i < -steps; i++ {
+			cdll.Head = cdll.Head.Prev
+			cdll.Tail = cdll.Tail.Prev
+		}
+	}
+}
+
+// Empty reports whether cdll has no elements.
+func (cdll *CircularDoublyLinkedList[T]) Empty() bool {
+	return cdll.size == 0
+}
+
+// Size returns the number of elements in cdll.
+func (cdll *CircularDoublyLinkedList[T]) Size() int {
+	return cdll.size
+}
+
+// Clear removes every element from cdll.
+func (cdll *CircularDoublyLinkedList[T]) Clear() {
+	cdll.Head = nil
+	cdll.Tail = nil
+	cdll.size = 0
+}
+
+// Values returns a slice of every element in cdll, from Head around to
+// Tail.
+func (cdll *CircularDoublyLinkedList[T]) Values() []T {
+	values := make([]T, 0, cdll.size)
+	current := cdll.Head
+	for i := 0; i < cdll.size; i++ {
+		values = append(values, current.Data)
+		current = current.Next
+	}
+	return values
+}
+
+// Iterator returns a fresh ReverseIterator positioned before Head,
+// bounded to a single pass around the ring.
+func (cdll *CircularDoublyLinkedList[T]) Iterator() ReverseIterator[T] {
+	it := &circularDoublyLinkedListIterator[T]{list: cdll}
+	it.Begin()
+	return it
+}
+
+type circularDoublyLinkedListIterator[T any] struct {
+	list  *CircularDoublyLinkedList[T]
+	node  *DoublyNode[T]
+	index int
+}
+
+func (it *circularDoublyLinkedListIterator[T]) Begin() {
+	it.node = nil
+	it.index = -1
+}
+
+func (it *circularDoublyLinkedListIterator[T]) End() {
+	it.node = nil
+	it.index = it.list.size
+}
+
+func (it *circularDoublyLinkedListIterator[T]) Next() bool {
+	if it.node == nil {
+		if it.index == it.list.size {
+			return false
+		}
+		it.node = it.list.Head
+	} else {
+		if it.index+1 >= it.list.size {
+			return false
+		}
+		it.node = it.node.Next
+	}
+
+	it.index++
+	return it.node != nil
+}
+
+func (it *circularDoublyLinkedListIterator[T]) Prev() bool {
+	if it.node == nil {
+		if it.index <= -1 {
+			return false
+		}
+		it.node = it.list.Tail
+	} else {
+		if it.index-1 < 0 {
+			return false
+		}
+		it.node = it.node.Prev
+	}
+
+	it.index--
+	return it.node != nil
+}
+
+func (it *circularDoublyLinkedListIterator[T]) Value() T {
+	return it.node.Data
+}
+
+func (it *circularDoublyLinkedListIterator[T]) Index() int {
+	return it.index
+}
@@ -0,0 +1,257 @@
+package collections
+
+// CircularSinglyLinkedList is a singly linked list over an arbitrary
+// element type T whose Tail points back to Head, forming a ring.
+// Delete and Search rely on the equals func supplied at construction
+// time to compare elements.
+type CircularSinglyLinkedList[T any] struct {
+	Head   *Node[T]
+	Tail   *Node[T]
+	size   int
+	equals func(a, b T) bool
+}
+
+// NewCircularSinglyLinkedList creates an empty
+// CircularSinglyLinkedList for a comparable element type, using the
+// built-in == operator for Delete and Search.
+func NewCircularSinglyLinkedList[T comparable]() *CircularSinglyLinkedList[T] {
+	return NewCircularSinglyLinkedListWithEqual(func(a, b T) bool { return a == b })
+}
+
+// NewCircularSinglyLinkedListWithEqual creates an empty
+// CircularSinglyLinkedList for an arbitrary element type T, using
+// equals to compare elements in Delete and Search.
+func NewCircularSinglyLinkedListWithEqual[T any](equals func(a, b T) bool) *CircularSinglyLinkedList[T] {
+	return &CircularSinglyLinkedList[T]{
+		equals: equals,
+	}
+}
+
+func (cll *CircularSinglyLinkedList[T]) Append(data T) {
+	node := &Node[T]{Data: data}
+
+	if cll.Head == nil {
+		node.Next = node
+		cll.Head = node
+		cll.Tail = node
+	} else {
+		node.Next = cll.Head
+		cll.Tail.Next = node
+		cll.Tail = node
+	}
+
+	cll.size++
+}
+
+func (cll *CircularSinglyLinkedList[T]) Prepend(data T) {
+	node := &Node[T]{Data: data}
+
+	if cll.Head == nil {
+		node.Next = node
+		cll.Head = node
+		cll.Tail = node
+	} else {
+		node.Next = cll.Head
+		cll.Head = node
+		cll.Tail.Next = node
+	}
+
+	cll.size++
+}
+
+func (cll *CircularSinglyLinkedList[T]) Delete(data T) {
+	if cll.Head == nil {
+		return
+	}
+
+	if cll.equals(cll.Head.Data, data) {
+		cll.removeHead()
+		return
+	}
+
+	prev := cll.Head
+	current := cll.Head.Next
+	for current != cll.Head && !cll.equals(current.Data, data) {
+		prev = current
+		current = current.Next
+	}
+
+	if current != cll.Head {
+		prev.Next = current.Next
+		if current == cll.Tail {
+			cll.Tail = prev
+		}
+		cll.size--
+	}
+}
+
+func (cll *CircularSinglyLinkedList[T]) removeHead() {
+	if cll.Head == cll.Tail {
+		cll.Head = nil
+		cll.Tail = nil
+	} else {
+		cll.Head = cll.Head.Next
+		cll.Tail.Next = cll.Head
+	}
+	cll.size--
+}
+
+func (cll *CircularSinglyLinkedList[T]) Search(data T) bool {
+	current := cll.Head
+	for i := 0; i < cll.size; i++ {
+		if cll.equals(current.Data, data) {
+			return true
+		}
+		current = current.Next
+	}
+	return false
+}
+
+// Get returns the element at index, counting forward from Head.
+func (cll *CircularSinglyLinkedList[T]) Get(index int) (T, bool) {
+	node := cll.nodeAt(index)
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.Data, true
+}
+
+// InsertAt inserts data at index, shifting the element currently at
+// index (and all that follow) one position towards Tail. Inserting at
+// Size appends to the end of the list.
+func (cll *CircularSinglyLinkedList[T]) InsertAt(index int, data T) {
+	if index <= 0 {
+		cll.Prepend(data)
+		return
+	}
+	if index >= cll.size {
+		cll.Append(data)
+		return
+	}
+
+	prev := cll.nodeAt(index - 1)
+	node := &Node[T]{Data: data, Next: prev.Next}
+	prev.Next = node
+	cll.size++
+}
+
+// RemoveAt removes the element at index, if any.
+func (cll *CircularSinglyLinkedList[T]) RemoveAt(index int) {
+	if index < 0 || index >= cll.size {
+		return
+	}
+	if index == 0 {
+		cll.removeHead()
+		return
+	}
+
+	prev := cll.nodeAt(index - 1)
+	current := prev.Next
+	prev.Next = current.Next
+	if current == cll.Tail {
+		cll.Tail = prev
+	}
+	cll.size--
+}
+
+func (cll *CircularSinglyLinkedList[T]) nodeAt(index int) *Node[T] {
+	if index < 0 || index >= cll.size {
+		return nil
+	}
+
+	current := cll.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// Rotate moves Head n steps forward (n > 0) or backward (n < 0) around
+// the ring in O(n) time, wrapping as many times as needed.
+func (cll *CircularSinglyLinkedList[T]) Rotate(n int) {
+	if cll.size == 0 {
+		return
+	}
+
+	steps := n % cll.size
+	if steps < 0 {
+		steps += cll.size
+	}
+
+	for i := 0; i < steps; i++ {
+		cll.Tail = cll.Head
+		cll.Head = cll.Head.Next
+	}
+}
+
+// Empty reports whether cll has no elements.
+func (cll *CircularSinglyLinkedList[T]) Empty() bool {
+	return cll.size == 0
+}
+
+// Size returns the number of elements in cll.
+func (cll *CircularSinglyLinkedList[T]) Size() int {
+	return cll.size
+}
+
+// Clear removes every element from cll.
+func (cll *CircularSinglyLinkedList[T]) Clear() {
+	cll.Head = nil
+	cll.Tail = nil
+	cll.size = 0
+}
+
+// Values returns a slice of every element in cll, from Head around to
+// Tail.
+func (cll *CircularSinglyLinkedList[T]) Values() []T {
+	values := make([]T, 0, cll.size)
+	current := cll.Head
+	for i := 0; i < cll.size; i++ {
+		values = append(values, current.Data)
+		current = current.Next
+	}
+	return values
+}
+
+// Iterator returns a fresh Iterator positioned before Head, bounded to
+// a single pass around the ring.
+func (cll *CircularSinglyLinkedList[T]) Iterator() Iterator[T] {
+	it := &circularSinglyLinkedListIterator[T]{list: cll}
+	it.Begin()
+	return it
+}
+
+type circularSinglyLinkedListIterator[T any] struct {
+	list  *CircularSinglyLinkedList[T]
+	node  *Node[T]
+	index int
+}
+
+func (it *circularSinglyLinkedListIterator[T]) Begin() {
+	it.node = nil
+	it.index = -1
+}
+
+func (it *circularSinglyLinkedListIterator[T]) Next() bool {
+	if it.index+1 >= it.list.size {
+		return false
+	}
+
+	if it.node == nil {
+		it.node = it.list.Head
+	} else {
+		it.node = it.node.Next
+	}
+
+	it.index++
+	return true
+}
+
+func (it *circularSinglyLinkedListIterator[T]) Value() T {
+	return it.node.Data
+}
+
+func (it *circularSinglyLinkedListIterator[T]) Index() int {
+	return it.index
+}
@@ -0,0 +1,27 @@
+package collections
+
+// Container is the common surface shared by the collections in this
+// package, mirroring the GoDS container design.
+type Container[T any] interface {
+	Empty() bool
+	Size() int
+	Clear()
+	Values() []T
+}
+
+// Iterator walks a Container's elements from the first to the last,
+// starting from the position set by Begin.
+type Iterator[T any] interface {
+	Next() bool
+	Value() T
+	Index() int
+	Begin()
+}
+
+// ReverseIterator extends Iterator with backward traversal, starting
+// from the position set by End.
+type ReverseIterator[T any] interface {
+	Iterator[T]
+	Prev() bool
+	End()
+}
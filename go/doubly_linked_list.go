@@ -0,0 +1,246 @@
+package collections
+
+// DoublyNode is a single element of a DoublyLinkedList, holding a value
+// of type T along with pointers to the previous and next DoublyNode.
+type DoublyNode[T any] struct {
+	Data T
+	Prev *DoublyNode[T]
+	Next *DoublyNode[T]
+}
+
+// DoublyLinkedList is a doubly linked list over an arbitrary element
+// type T. Delete and Search rely on the equals func supplied at
+// construction time to compare elements. DoublyLinkedList implements
+// Container[T].
+type DoublyLinkedList[T any] struct {
+	Head   *DoublyNode[T]
+	Tail   *DoublyNode[T]
+	size   int
+	equals func(a, b T) bool
+}
+
+// NewDoublyLinkedList creates an empty DoublyLinkedList for a
+// comparable element type, using the built-in == operator for Delete
+// and Search.
+func NewDoublyLinkedList[T comparable]() *DoublyLinkedList[T] {
+	return NewDoublyLinkedListWithEqual(func(a, b T) bool { return a == b })
+}
+
+// NewDoublyLinkedListWithEqual creates an empty DoublyLinkedList for an
+// arbitrary element type T, using equals to compare elements in Delete
+// and Search.
+func NewDoublyLinkedListWithEqual[T any](equals func(a, b T) bool) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{
+		Head:   nil,
+		Tail:   nil,
+		size:   0,
+		equals: equals,
+	}
+}
+
+func (dll *DoublyLinkedList[T]) Append(data T) {
+	node := &DoublyNode[T]{Data: data}
+
+	if dll.Head == nil {
+		dll.Head = node
+		dll.Tail = node
+	} else {
+		node.Prev = dll.Tail
+		dll.Tail.Next = node
+		dll.Tail = node
+	}
+
+	dll.size++
+}
+
+func (dll *DoublyLinkedList[T]) Prepend(data T) {
+	node := &DoublyNode[T]{Data: data}
+
+	if dll.Head == nil {
+		dll.Head = node
+		dll.Tail = node
+	} else {
+		node.Next = dll.Head
+		dll.Head.Prev = node
+		dll.Head = node
+	}
+
+	dll.size++
+}
+
+func (dll *DoublyLinkedList[T]) Delete(data T) {
+	current := dll.Head
+	for current != nil && !dll.equals(current.Data, data) {
+		current = current.Next
+	}
+
+	if current == nil {
+		return
+	}
+
+	dll.unlink(current)
+}
+
+func (dll *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) {
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		dll.Head = node.Next
+	}
+
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		dll.Tail = node.Prev
+	}
+
+	dll.size--
+}
+
+func (dll *DoublyLinkedList[T]) Search(data T) bool {
+	current := dll.Head
+	for current != nil {
+		if dll.equals(current.Data, data) {
+			return true
+		}
+		current = current.Next
+	}
+	return false
+}
+
+// Get returns the element at index, counting forward from Head.
+func (dll *DoublyLinkedList[T]) Get(index int) (T, bool) {
+	node := dll.nodeAt(index)
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.Data, true
+}
+
+// InsertAt inserts data at index, shifting the element currently at
+// index (and all that follow) one position to the right. Inserting at
+// Size appends to the end of the list.
+func (dll *DoublyLinkedList[T]) InsertAt(index int, data T) {
+	if index <= 0 {
+		dll.Prepend(data)
+		return
+	}
+	if index >= dll.size {
+		dll.Append(data)
+		return
+	}
+
+	next := dll.nodeAt(index)
+	node := &DoublyNode[T]{Data: data, Prev: next.Prev, Next: next}
+	next.Prev.Next = node
+	next.Prev = node
+	dll.size++
+}
+
+// RemoveAt removes the element at index, if any.
+func (dll *DoublyLinkedList[T]) RemoveAt(index int) {
+	node := dll.nodeAt(index)
+	if node == nil {
+		return
+	}
+	dll.unlink(node)
+}
+
+func (dll *DoublyLinkedList[T]) nodeAt(index int) *DoublyNode[T] {
+	if index < 0 || index >= dll.size {
+		return nil
+	}
+
+	current := dll.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// Empty reports whether dll has no elements.
+func (dll *DoublyLinkedList[T]) Empty() bool {
+	return dll.size == 0
+}
+
+// Size returns the number of elements in dll.
+func (dll *DoublyLinkedList[T]) Size() int {
+	return dll.size
+}
+
+// Clear removes every element from dll.
+func (dll *DoublyLinkedList[T]) Clear() {
+	dll.Head = nil
+	dll.Tail = nil
+	dll.size = 0
+}
+
+// Values returns a slice of every element in dll, from Head to Tail.
+func (dll *DoublyLinkedList[T]) Values() []T {
+	values := make([]T, 0, dll.size)
+	for current := dll.Head; current != nil; current = current.Next {
+		values = append(values, current.Data)
+	}
+	return values
+}
+
+// Iterator returns a fresh ReverseIterator positioned before the first
+// element of dll.
+func (dll *DoublyLinkedList[T]) Iterator() ReverseIterator[T] {
+	it := &doublyLinkedListIterator[T]{list: dll}
+	it.Begin()
+	return it
+}
+
+type doublyLinkedListIterator[T any] struct {
+	list  *DoublyLinkedList[T]
+	node  *DoublyNode[T]
+	index int
+}
+
+func (it *doublyLinkedListIterator[T]) Begin() {
+	it.node = nil
+	it.index = -1
+}
+
+func (it *doublyLinkedListIterator[T]) End() {
+	it.node = nil
+	it.index = it.list.size
+}
+
+func (it *doublyLinkedListIterator[T]) Next() bool {
+	if it.node == nil {
+		if it.index == it.list.size {
+			return false
+		}
+		it.node = it.list.Head
+	} else {
+		it.node = it.node.Next
+	}
+
+	it.index++
+	return it.node != nil
+}
+
+func (it *doublyLinkedListIterator[T]) Prev() bool {
+	if it.node == nil {
+		if it.index == -1 {
+			return false
+		}
+		it.node = it.list.Tail
+	} else {
+		it.node = it.node.Prev
+	}
+
+	it.index--
+	return it.node != nil
+}
+
+func (it *doublyLinkedListIterator[T]) Value() T {
+	return it.node.Data
+}
+
+func (it *doublyLinkedListIterator[T]) Index() int {
+	return it.index
+}
@@ -1,9 +1,20 @@
 package collections
 
+// GCD returns the greatest common divisor of a and b via the classical
+// Euclidean recurrence gcd(a, b) = gcd(b, a mod b), with base case
+// gcd(a, 0) = a. Negative inputs are handled via their absolute value.
 func GCD(a int, b int) int {
-	if a%b == 0 {
-		return b
+	a, b = abs(a), abs(b)
+	if b == 0 {
+		return a
 	}
 
-	return GCD(b, b%a)
+	return GCD(b, a%b)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
 }
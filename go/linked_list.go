@@ -1,26 +1,42 @@
 package collections
 
-type Node struct {
-	Data int
-	Next *Node
+// Node is a single element of a LinkedList, holding a value of type T
+// and a pointer to the next Node in the list.
+type Node[T any] struct {
+	Data T
+	Next *Node[T]
 }
 
-type LinkedList struct {
-	Head *Node
-	Tail *Node
-	Size int
+// LinkedList is a singly linked list over an arbitrary element type T.
+// Delete and Search rely on the equals func supplied at construction
+// time to compare elements. LinkedList implements Container[T].
+type LinkedList[T any] struct {
+	Head   *Node[T]
+	Tail   *Node[T]
+	size   int
+	equals func(a, b T) bool
 }
 
-func NewLinkedList() *LinkedList {
-	return &LinkedList{
-		Head: nil,
-		Tail: nil,
-		Size: 0,
+// NewLinkedList creates an empty LinkedList for a comparable element
+// type, using the built-in == operator for Delete and Search.
+func NewLinkedList[T comparable]() *LinkedList[T] {
+	return NewLinkedListWithEqual(func(a, b T) bool { return a == b })
+}
+
+// NewLinkedListWithEqual creates an empty LinkedList for an arbitrary
+// element type T, using equals to compare elements in Delete and
+// Search.
+func NewLinkedListWithEqual[T any](equals func(a, b T) bool) *LinkedList[T] {
+	return &LinkedList[T]{
+		Head:   nil,
+		Tail:   nil,
+		size:   0,
+		equals: equals,
 	}
 }
 
-func (ll *LinkedList) Append(data int) {
-	node := &Node{
+func (ll *LinkedList[T]) Append(data T) {
+	node := &Node[T]{
 		Data: data,
 		Next: nil,
 	}
@@ -33,11 +49,11 @@ func (ll *LinkedList) Append(data int) {
 		ll.Tail = node
 	}
 
-	ll.Size++
+	ll.size++
 }
 
-func (ll *LinkedList) Prepend(data int) {
-	node := &Node{
+func (ll *LinkedList[T]) Prepend(data T) {
+	node := &Node[T]{
 		Data: data,
 		Next: nil,
 	}
@@ -50,22 +66,22 @@ func (ll *LinkedList) Prepend(data int) {
 		ll.Head = node
 	}
 
-	ll.Size++
+	ll.size++
 }
 
-func (ll *LinkedList) Delete(data int) {
+func (ll *LinkedList[T]) Delete(data T) {
 	if ll.Head == nil {
 		return
 	}
 
-	if ll.Head.Data == data {
+	if ll.equals(ll.Head.Data, data) {
 		ll.Head = ll.Head.Next
-		ll.Size--
+		ll.size--
 		return
 	}
 
 	current := ll.Head
-	for current.Next != nil && current.Next.Data != data {
+	for current.Next != nil && !ll.equals(current.Next.Data, data) {
 		current = current.Next
 	}
 
@@ -74,14 +90,14 @@ func (ll *LinkedList) Delete(data int) {
 		if current.Next == nil {
 			ll.Tail = current
 		}
-		ll.Size--
+		ll.size--
 	}
 }
 
-func (ll *LinkedList) Search(data int) bool {
+func (ll *LinkedList[T]) Search(data T) bool {
 	current := ll.Head
 	for current != nil {
-		if current.Data == data {
+		if ll.equals(current.Data, data) {
 			return true
 		}
 		current = current.Next
@@ -89,15 +105,69 @@ func (ll *LinkedList) Search(data int) bool {
 	return false
 }
 
-func (ll *LinkedList) Traverse() {
-	current := ll.Head
-	for current != nil {
-		print(current.Data, " ")
-		current = current.Next
+// Empty reports whether ll has no elements.
+func (ll *LinkedList[T]) Empty() bool {
+	return ll.size == 0
+}
+
+// Size returns the number of elements in ll.
+func (ll *LinkedList[T]) Size() int {
+	return ll.size
+}
+
+// Clear removes every element from ll.
+func (ll *LinkedList[T]) Clear() {
+	ll.Head = nil
+	ll.Tail = nil
+	ll.size = 0
+}
+
+// Values returns a slice of every element in ll, from Head to Tail.
+func (ll *LinkedList[T]) Values() []T {
+	values := make([]T, 0, ll.size)
+	for current := ll.Head; current != nil; current = current.Next {
+		values = append(values, current.Data)
 	}
-	println()
+	return values
+}
+
+// Iterator returns a fresh Iterator positioned before the first
+// element of ll.
+func (ll *LinkedList[T]) Iterator() Iterator[T] {
+	it := &linkedListIterator[T]{list: ll}
+	it.Begin()
+	return it
+}
+
+type linkedListIterator[T any] struct {
+	list  *LinkedList[T]
+	node  *Node[T]
+	index int
+}
+
+func (it *linkedListIterator[T]) Begin() {
+	it.node = nil
+	it.index = -1
+}
+
+func (it *linkedListIterator[T]) Next() bool {
+	if it.node == nil {
+		if it.index >= 0 {
+			return false
+		}
+		it.node = it.list.Head
+	} else {
+		it.node = it.node.Next
+	}
+
+	it.index++
+	return it.node != nil
+}
+
+func (it *linkedListIterator[T]) Value() T {
+	return it.node.Data
 }
 
-func (ll *LinkedList) GetSize() int {
-	return ll.Size
+func (it *linkedListIterator[T]) Index() int {
+	return it.index
 }
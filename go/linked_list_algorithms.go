@@ -0,0 +1,136 @@
+package collections
+
+// Ordered is satisfied by any type whose values can be compared with
+// the standard relational operators. It exists so Partition and Merge
+// can require an ordering without tightening LinkedList's own T any
+// constraint.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 | ~string
+}
+
+// Reverse reverses the list in place in O(n) time and O(1) space.
+func (ll *LinkedList[T]) Reverse() {
+	var prev *Node[T]
+	current := ll.Head
+	ll.Tail = ll.Head
+
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev = current
+		current = next
+	}
+
+	ll.Head = prev
+}
+
+// IsPalindrome reports whether the list reads the same forwards and
+// backwards, using the equals func supplied at construction.
+func (ll *LinkedList[T]) IsPalindrome() bool {
+	values := make([]T, 0, ll.size)
+	for current := ll.Head; current != nil; current = current.Next {
+		values = append(values, current.Data)
+	}
+
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		if !ll.equals(values[i], values[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Partition splits ll into a new list with every element less than
+// pivot first, followed by every element greater than or equal to
+// pivot, preserving relative order within each half (CtCI 2.4).
+func Partition[T Ordered](ll *LinkedList[T], pivot T) *LinkedList[T] {
+	before := NewLinkedList[T]()
+	after := NewLinkedList[T]()
+
+	for current := ll.Head; current != nil; current = current.Next {
+		if current.Data < pivot {
+			before.Append(current.Data)
+		} else {
+			after.Append(current.Data)
+		}
+	}
+
+	for current := after.Head; current != nil; current = current.Next {
+		before.Append(current.Data)
+	}
+
+	return before
+}
+
+// Merge merges ll and other, both assumed sorted in ascending order,
+// into a new sorted list.
+func Merge[T Ordered](ll *LinkedList[T], other *LinkedList[T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+
+	a, b := ll.Head, other.Head
+	for a != nil && b != nil {
+		if a.Data <= b.Data {
+			result.Append(a.Data)
+			a = a.Next
+		} else {
+			result.Append(b.Data)
+			b = b.Next
+		}
+	}
+
+	for ; a != nil; a = a.Next {
+		result.Append(a.Data)
+	}
+	for ; b != nil; b = b.Next {
+		result.Append(b.Data)
+	}
+
+	return result
+}
+
+// DetectCycle returns the node where a cycle begins, or nil if ll is
+// acyclic, using Floyd's tortoise-and-hare algorithm: advance slow one
+// step and fast two steps until they meet, then reset slow to Head and
+// advance both one step at a time until they meet again at the cycle
+// entry.
+func (ll *LinkedList[T]) DetectCycle() *Node[T] {
+	slow, fast := ll.Head, ll.Head
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+
+		if slow == fast {
+			slow = ll.Head
+			for slow != fast {
+				slow = slow.Next
+				fast = fast.Next
+			}
+			return slow
+		}
+	}
+
+	return nil
+}
+
+// HasCycle reports whether ll contains a cycle.
+func (ll *LinkedList[T]) HasCycle() bool {
+	return ll.DetectCycle() != nil
+}
+
+// linkCycle is an unexported test helper. ll is acyclic by
+// construction, so DetectCycle/HasCycle can only be exercised by
+// artificially pointing Tail.Next at the node `entry` steps from Head.
+func (ll *LinkedList[T]) linkCycle(entry int) {
+	if ll.Tail == nil {
+		return
+	}
+
+	node := ll.Head
+	for i := 0; i < entry; i++ {
+		node = node.Next
+	}
+	ll.Tail.Next = node
+}
@@ -0,0 +1,47 @@
+package collections
+
+import "testing"
+
+func TestDetectCycleNoCycle(t *testing.T) {
+	l := NewLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+
+	if l.DetectCycle() != nil {
+		t.Errorf("Expected no cycle in an acyclic list")
+	}
+	if l.HasCycle() {
+		t.Errorf("Expected HasCycle to be false for an acyclic list")
+	}
+}
+
+func TestDetectCycleEntryNode(t *testing.T) {
+	l := NewLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+	l.Append(4)
+	l.Append(5)
+	l.linkCycle(2) // Tail.Next -> node with Data == 3
+
+	entry := l.DetectCycle()
+	if entry == nil || entry.Data != 3 {
+		t.Errorf("Expected cycle entry to be the node with Data 3")
+	}
+	if !l.HasCycle() {
+		t.Errorf("Expected HasCycle to be true")
+	}
+}
+
+func TestDetectCycleWholeListIsCycle(t *testing.T) {
+	l := NewLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.linkCycle(0) // Tail.Next -> Head
+
+	entry := l.DetectCycle()
+	if entry != l.Head {
+		t.Errorf("Expected cycle entry to be Head when the whole list cycles")
+	}
+}
@@ -0,0 +1,103 @@
+package collections
+
+// LCM returns the least common multiple of a and b, computed as
+// (a/GCD(a,b))*b so the division happens before the multiplication to
+// reduce the risk of intermediate overflow.
+func LCM(a int, b int) int {
+	a, b = abs(a), abs(b)
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return (a / GCD(a, b)) * b
+}
+
+// ExtendedGCD returns g = gcd(a, b) along with coefficients x and y
+// such that a*x + b*y = g (Bezout's identity).
+func ExtendedGCD(a int, b int) (g int, x int, y int) {
+	if b == 0 {
+		if a < 0 {
+			return -a, -1, 0
+		}
+		return a, 1, 0
+	}
+
+	g, x1, y1 := ExtendedGCD(b, a%b)
+	return g, y1, x1 - (a/b)*y1
+}
+
+// ModInverse returns the modular multiplicative inverse of a modulo m,
+// built on ExtendedGCD. It reports false if no inverse exists, i.e.
+// gcd(a, m) != 1.
+func ModInverse(a int, m int) (int, bool) {
+	g, x, _ := ExtendedGCD(a, m)
+	if g != 1 {
+		return 0, false
+	}
+
+	return ((x % m) + m) % m, true
+}
+
+// ModPow computes (base^exp) mod m using fast exponentiation in
+// O(log exp) time.
+func ModPow(base int, exp int, mod int) int {
+	if mod == 1 {
+		return 0
+	}
+
+	result := 1
+	base = base % mod
+	if base < 0 {
+		base += mod
+	}
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = (result * base) % mod
+		}
+		exp >>= 1
+		base = (base * base) % mod
+	}
+
+	return result
+}
+
+// IsPrime reports whether n is a prime number via trial division up to
+// sqrt(n).
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+
+	for i := 2; i*i <= n; i++ {
+		if n%i == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SieveOfEratosthenes returns every prime number in [2, n] using the
+// sieve of Eratosthenes.
+func SieveOfEratosthenes(n int) []int {
+	if n < 2 {
+		return []int{}
+	}
+
+	isComposite := make([]bool, n+1)
+	primes := make([]int, 0)
+
+	for i := 2; i <= n; i++ {
+		if isComposite[i] {
+			continue
+		}
+
+		primes = append(primes, i)
+		for j := i * i; j <= n; j += i {
+			isComposite[j] = true
+		}
+	}
+
+	return primes
+}
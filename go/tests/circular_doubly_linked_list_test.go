@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"testing"
+
+	collections "github.com/jalusw/collections/go"
+)
+
+func TestCircularDoublyLinkedList(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+	l.Prepend(0)
+	l.Delete(2)
+
+	if l.Head.Data != 0 {
+		t.Errorf("Expected head data to be 0, got %d", l.Head.Data)
+	}
+	if l.Tail.Data != 3 {
+		t.Errorf("Expected tail data to be 3, got %d", l.Tail.Data)
+	}
+	if l.Size() != 3 {
+		t.Errorf("Expected size to be 3, got %d", l.Size())
+	}
+	if l.Tail.Next != l.Head || l.Head.Prev != l.Tail {
+		t.Errorf("Expected the list to wrap around in both directions")
+	}
+}
+
+func TestCircularDoublyLinkedListEmpty(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+
+	if l.Size() != 0 {
+		t.Errorf("Expected size 0 for empty list, got %d", l.Size())
+	}
+	l.Delete(1)
+	l.RemoveAt(0)
+	l.Rotate(3)
+	if l.Head != nil || l.Tail != nil {
+		t.Errorf("Expected empty list to remain empty")
+	}
+}
+
+func TestCircularDoublyLinkedListSingleElement(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+	l.Append(42)
+
+	if l.Head != l.Tail || l.Head.Next != l.Head || l.Head.Prev != l.Head {
+		t.Errorf("Expected single-element list to point to itself in both directions")
+	}
+
+	l.Delete(42)
+	if l.Head != nil || l.Tail != nil || l.Size() != 0 {
+		t.Errorf("Expected list to be empty after deleting its only element")
+	}
+}
+
+func TestCircularDoublyLinkedListRotate(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		l.Append(v)
+	}
+
+	l.Rotate(2)
+	if got, _ := l.Get(0); got != 3 {
+		t.Errorf("Expected head to be 3 after Rotate(2), got %d", got)
+	}
+
+	l.Rotate(13) // 13 % 5 == 3, wraps more than once
+	if got, _ := l.Get(0); got != 1 {
+		t.Errorf("Expected head to be 1 after Rotate(13), got %d", got)
+	}
+
+	l.Rotate(-2)
+	if got, _ := l.Get(0); got != 4 {
+		t.Errorf("Expected head to be 4 after Rotate(-2), got %d", got)
+	}
+}
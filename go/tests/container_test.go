@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"testing"
+
+	collections "github.com/jalusw/collections/go"
+)
+
+func TestLinkedListContainer(t *testing.T) {
+	l := collections.NewLinkedList[int]()
+	if !l.Empty() {
+		t.Errorf("Expected a new list to be empty")
+	}
+
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+
+	if l.Empty() {
+		t.Errorf("Expected list to be non-empty after Append")
+	}
+	if l.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", l.Size())
+	}
+
+	want := []int{1, 2, 3}
+	values := l.Values()
+	for i, v := range want {
+		if values[i] != v {
+			t.Errorf("Values()[%d] = %d, want %d", i, values[i], v)
+		}
+	}
+
+	l.Clear()
+	if !l.Empty() || l.Size() != 0 || l.Head != nil || l.Tail != nil {
+		t.Errorf("Expected list to be empty after Clear")
+	}
+}
+
+func TestLinkedListIterator(t *testing.T) {
+	l := collections.NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.Append(v)
+	}
+
+	it := l.Iterator()
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Iterator visited %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Iterator element %d = %d, want %d", i, got[i], v)
+		}
+	}
+
+	it.Begin()
+	if !it.Next() || it.Value() != 1 || it.Index() != 0 {
+		t.Errorf("Expected Begin to reset the iterator to the first element")
+	}
+}
+
+func TestDoublyLinkedListReverseIterator(t *testing.T) {
+	l := collections.NewDoublyLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.Append(v)
+	}
+
+	it := l.Iterator()
+	it.End()
+	var got []int
+	for it.Prev() {
+		got = append(got, it.Value())
+	}
+
+	want := []int{3, 2, 1}
+	if len(got) != len(want) {
+		t.Fatalf("Reverse iteration visited %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Reverse iterator element %d = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+func TestCircularSinglyLinkedListIterator(t *testing.T) {
+	l := collections.NewCircularSinglyLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.Append(v)
+	}
+
+	it := l.Iterator()
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Iterator visited %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Iterator element %d = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+func TestCircularSinglyLinkedListIteratorEmpty(t *testing.T) {
+	l := collections.NewCircularSinglyLinkedList[int]()
+
+	it := l.Iterator()
+	if it.Next() {
+		t.Errorf("Expected Next to return false on an empty list")
+	}
+}
+
+func TestCircularDoublyLinkedListIterator(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.Append(v)
+	}
+
+	it := l.Iterator()
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Iterator visited %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Iterator element %d = %d, want %d", i, got[i], v)
+		}
+	}
+
+	it.End()
+	var back []int
+	for it.Prev() {
+		back = append(back, it.Value())
+	}
+
+	wantBack := []int{3, 2, 1}
+	if len(back) != len(wantBack) {
+		t.Fatalf("Reverse iteration visited %v, want %v", back, wantBack)
+	}
+	for i, v := range wantBack {
+		if back[i] != v {
+			t.Errorf("Reverse iterator element %d = %d, want %d", i, back[i], v)
+		}
+	}
+}
+
+func TestCircularDoublyLinkedListIteratorEmpty(t *testing.T) {
+	l := collections.NewCircularDoublyLinkedList[int]()
+
+	it := l.Iterator()
+	if it.Next() {
+		t.Errorf("Expected Next to return false on an empty list")
+	}
+
+	it.End()
+	if it.Prev() {
+		t.Errorf("Expected Prev to return false on an empty list")
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"testing"
+
+	collections "github.com/jalusw/collections/go"
+)
+
+func TestDoublyLinkedList(t *testing.T) {
+	l := collections.NewDoublyLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+	l.Prepend(0)
+	l.Delete(2)
+
+	if l.Head.Data != 0 {
+		t.Errorf("Expected head data to be 0, got %d", l.Head.Data)
+	}
+	if l.Tail.Data != 3 {
+		t.Errorf("Expected tail data to be 3, got %d", l.Tail.Data)
+	}
+	if l.Size() != 3 {
+		t.Errorf("Expected size to be 3, got %d", l.Size())
+	}
+	if l.Head.Next.Data != 1 {
+		t.Errorf("Expected second node data to be 1, got %d", l.Head.Next.Data)
+	}
+	if l.Tail.Prev.Data != 1 {
+		t.Errorf("Expected node before tail to be 1, got %d", l.Tail.Prev.Data)
+	}
+}
+
+func TestDoublyLinkedListEmpty(t *testing.T) {
+	l := collections.NewDoublyLinkedList[int]()
+
+	if l.Size() != 0 {
+		t.Errorf("Expected size 0 for empty list, got %d", l.Size())
+	}
+	if _, ok := l.Get(0); ok {
+		t.Errorf("Expected Get(0) to fail on empty list")
+	}
+	l.Delete(1)
+	l.RemoveAt(0)
+	if l.Head != nil || l.Tail != nil {
+		t.Errorf("Expected empty list to remain empty after no-op Delete/RemoveAt")
+	}
+}
+
+func TestDoublyLinkedListSingleElement(t *testing.T) {
+	l := collections.NewDoublyLinkedList[int]()
+	l.Append(42)
+
+	if l.Head != l.Tail {
+		t.Errorf("Expected Head and Tail to be the same node for a single element")
+	}
+
+	l.Delete(42)
+	if l.Head != nil || l.Tail != nil || l.Size() != 0 {
+		t.Errorf("Expected list to be empty after deleting its only element")
+	}
+}
+
+func TestDoublyLinkedListInsertAndRemoveAt(t *testing.T) {
+	l := collections.NewDoublyLinkedList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(4)
+	l.InsertAt(2, 3)
+
+	for i, want := range []int{1, 2, 3, 4} {
+		got, ok := l.Get(i)
+		if !ok || got != want {
+			t.Errorf("Get(%d) = %d, %v; want %d, true", i, got, ok, want)
+		}
+	}
+
+	l.RemoveAt(0)
+	if got, _ := l.Get(0); got != 2 {
+		t.Errorf("Expected first element to be 2 after RemoveAt(0), got %d", got)
+	}
+	if l.Size() != 3 {
+		t.Errorf("Expected size 3 after RemoveAt, got %d", l.Size())
+	}
+}
@@ -3,15 +3,25 @@ package tests
 import (
 	"testing"
 
-	dsa "github.com/jalusw/datastructures-algorithms/go"
+	dsa "github.com/jalusw/collections/go"
 )
 
-func TestGCD(t *testing.T){
-	a := 10
-	b := 5
-	gcd := dsa.GCD(a,b)
+func TestGCD(t *testing.T) {
+	cases := []struct {
+		a, b, want int
+	}{
+		{10, 5, 5},
+		{12, 18, 6},
+		{17, 5, 1},
+		{0, 5, 5},
+		{5, 0, 5},
+		{-12, 18, 6},
+		{12, -18, 6},
+	}
 
-	if gcd != 5 {
-		t.Errorf("Wrong Answer for case 10 and 5")
+	for _, c := range cases {
+		if got := dsa.GCD(c.a, c.b); got != c.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+		}
 	}
 }
@@ -7,12 +7,19 @@ import (
 )
 
 func TestLCM(t *testing.T) {
-
-	a := 10
-	b := 5
-
-	if dsa.LCM(a, b) != 10 {
-		t.Errorf("Wrong LCM answer for 10 and 5")
+	cases := []struct {
+		a, b, want int
+	}{
+		{10, 5, 10},
+		{4, 6, 12},
+		{0, 5, 0},
+		{7, 7, 7},
+		{-4, 6, 12},
 	}
 
+	for _, c := range cases {
+		if got := dsa.LCM(c.a, c.b); got != c.want {
+			t.Errorf("LCM(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
 }
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"testing"
+
+	collections "github.com/jalusw/collections/go"
+)
+
+func TestLinkedListReverse(t *testing.T) {
+	l := collections.NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3, 4} {
+		l.Append(v)
+	}
+
+	l.Reverse()
+
+	want := []int{4, 3, 2, 1}
+	current := l.Head
+	for _, v := range want {
+		if current == nil || current.Data != v {
+			t.Fatalf("Reverse mismatch: want %v", want)
+		}
+		current = current.Next
+	}
+	if l.Tail.Data != 1 {
+		t.Errorf("Expected tail data to be 1 after Reverse, got %d", l.Tail.Data)
+	}
+}
+
+func TestLinkedListIsPalindrome(t *testing.T) {
+	palindrome := collections.NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3, 2, 1} {
+		palindrome.Append(v)
+	}
+	if !palindrome.IsPalindrome() {
+		t.Errorf("Expected 1,2,3,2,1 to be a palindrome")
+	}
+
+	notPalindrome := collections.NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		notPalindrome.Append(v)
+	}
+	if notPalindrome.IsPalindrome() {
+		t.Errorf("Expected 1,2,3 to not be a palindrome")
+	}
+}
+
+func TestLinkedListPartition(t *testing.T) {
+	l := collections.NewLinkedList[int]()
+	for _, v := range []int{3, 5, 8, 5, 10, 2, 1} {
+		l.Append(v)
+	}
+
+	result := collections.Partition(l, 5)
+
+	var values []int
+	for current := result.Head; current != nil; current = current.Next {
+		values = append(values, current.Data)
+	}
+
+	want := []int{3, 2, 1, 5, 8, 5, 10}
+	if len(values) != len(want) {
+		t.Fatalf("Partition(l, 5) = %v, want %v", values, want)
+	}
+	for i, v := range want {
+		if values[i] != v {
+			t.Errorf("Partition(l, 5)[%d] = %d, want %d (full result %v)", i, values[i], v, values)
+		}
+	}
+}
+
+func TestLinkedListMerge(t *testing.T) {
+	a := collections.NewLinkedList[int]()
+	for _, v := range []int{1, 3, 5} {
+		a.Append(v)
+	}
+
+	b := collections.NewLinkedList[int]()
+	for _, v := range []int{2, 4, 6} {
+		b.Append(v)
+	}
+
+	merged := collections.Merge(a, b)
+
+	want := []int{1, 2, 3, 4, 5, 6}
+	current := merged.Head
+	for _, v := range want {
+		if current == nil || current.Data != v {
+			t.Fatalf("Merge mismatch: want %v", want)
+		}
+		current = current.Next
+	}
+}
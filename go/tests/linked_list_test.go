@@ -7,7 +7,7 @@ import (
 )
 
 func TestLinkedList(t *testing.T) {
-	l := collections.NewLinkedList()
+	l := collections.NewLinkedList[int]()
 	l.Append(1)
 	l.Append(2)
 	l.Append(3)
@@ -21,8 +21,8 @@ func TestLinkedList(t *testing.T) {
 		t.Errorf("Expected tail data to be 3, got %d", l.Tail.Data)
 	}
 
-	if l.Size != 3 {
-		t.Errorf("Expected size to be 3, got %d", l.Size)
+	if l.Size() != 3 {
+		t.Errorf("Expected size to be 3, got %d", l.Size())
 	}
 
 	if l.Head.Next.Data != 1 {
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"testing"
+
+	dsa "github.com/jalusw/collections/go"
+)
+
+func TestExtendedGCD(t *testing.T) {
+	cases := []struct {
+		a, b, wantG int
+	}{
+		{35, 15, 5},
+		{12, 18, 6},
+		{17, 5, 1},
+	}
+
+	for _, c := range cases {
+		g, x, y := dsa.ExtendedGCD(c.a, c.b)
+		if g != c.wantG {
+			t.Errorf("ExtendedGCD(%d, %d) gcd = %d, want %d", c.a, c.b, g, c.wantG)
+		}
+		if c.a*x+c.b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d; %d*%d + %d*%d != %d", c.a, c.b, g, x, y, c.a, x, c.b, y, g)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	inv, ok := dsa.ModInverse(3, 11)
+	if !ok || (3*inv)%11 != 1 {
+		t.Errorf("ModInverse(3, 11) = %d, %v; want an inverse satisfying 3*x mod 11 == 1", inv, ok)
+	}
+
+	if _, ok := dsa.ModInverse(2, 4); ok {
+		t.Errorf("Expected ModInverse(2, 4) to fail since gcd(2, 4) != 1")
+	}
+}
+
+func TestModPow(t *testing.T) {
+	cases := []struct {
+		base, exp, mod, want int
+	}{
+		{2, 10, 1000, 24},
+		{3, 0, 7, 1},
+		{5, 3, 13, 8},
+	}
+
+	for _, c := range cases {
+		if got := dsa.ModPow(c.base, c.exp, c.mod); got != c.want {
+			t.Errorf("ModPow(%d, %d, %d) = %d, want %d", c.base, c.exp, c.mod, got, c.want)
+		}
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	cases := []struct {
+		n    int
+		want bool
+	}{
+		{-1, false},
+		{0, false},
+		{1, false},
+		{2, true},
+		{17, true},
+		{18, false},
+	}
+
+	for _, c := range cases {
+		if got := dsa.IsPrime(c.n); got != c.want {
+			t.Errorf("IsPrime(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+}
+
+func TestSieveOfEratosthenes(t *testing.T) {
+	want := []int{2, 3, 5, 7, 11, 13, 17, 19}
+	got := dsa.SieveOfEratosthenes(20)
+
+	if len(got) != len(want) {
+		t.Fatalf("SieveOfEratosthenes(20) = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("SieveOfEratosthenes(20)[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+
+	if len(dsa.SieveOfEratosthenes(1)) != 0 {
+		t.Errorf("Expected no primes <= 1")
+	}
+}